@@ -0,0 +1,350 @@
+package ai
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+/*
+ * InfoState represents what a single player can observe about the game so
+ * far: the sequence of moves that have led to the current decision, without
+ * resolving any of the hidden information (e.g. opponents' hands) that a
+ * concrete TSState would carry. Two different concrete worlds that have
+ * produced the same observations are represented by the same InfoState.
+ */
+type InfoState interface {
+	/*
+	 * Key returns a string that uniquely identifies this information set, so
+	 * that the search tree can collapse every determinization that shares the
+	 * same observed history onto a single node.
+	 */
+	Key() string
+}
+
+/*
+ * ISEngine is the game logic required to run ISMCTS. It differs from
+ * TSEngine in that searching happens over information sets rather than fully
+ * observable states, since the player on move cannot see the other players'
+ * hands.
+ */
+type ISEngine interface {
+	/*
+	 * Determinize samples a single concrete TSState that is consistent with
+	 * everything infoState has observed (e.g. using noSuits in the euchre
+	 * package to avoid dealing a card to a player known not to hold it).
+	 */
+	Determinize(infoState InfoState) TSState
+
+	/*
+	 * Legal returns the moves that are legal in the given concrete state. The
+	 * information set a move leads to comes separately from Observe, so the
+	 * tree can be descended by information set even though the simulation
+	 * itself runs on concrete states.
+	 */
+	Legal(state TSState) []Move
+
+	/*
+	 * Observe returns the InfoState that results from taking move from state,
+	 * as seen by the player who is on move in the resulting state.
+	 */
+	Observe(state TSState, move Move) InfoState
+
+	/*
+	 * IsTerminal returns whether state is a terminal state of the game.
+	 */
+	IsTerminal(state TSState) bool
+
+	/*
+	 * Rollout plays a concrete, terminal-bound state out to completion using
+	 * a random policy and returns its evaluation from the root player's
+	 * perspective.
+	 */
+	Rollout(state TSState) float64
+
+	/*
+	 * Favorable returns whether the player on move in state is the one ISMCTS
+	 * is searching on behalf of (as opposed to an opponent), mirroring
+	 * TSEngine.Favorable so that UCB1 can be maximized or minimized
+	 * correctly.
+	 */
+	Favorable(state TSState) bool
+}
+
+/*
+ * isNode is a single node of the information-set tree, keyed by the
+ * information set it represents rather than by a concrete world. A node's
+ * children map is keyed by the string form of the move that leads to them
+ * (since the same move can be legal in many different determinizations), but
+ * the *isNode each of those moves points to is shared across every parent
+ * that reaches the same information set, via the nodes table passed to
+ * nodeFor. That sharing is what lets two different move sequences that
+ * transpose into the same information set pool their statistics instead of
+ * maintaining independent copies.
+ */
+type isNode struct {
+	key      string
+	visits   float64
+	avail    float64
+	total    float64
+	children map[string]*isNode
+	moves    map[string]Move
+}
+
+/*
+ * newISNode creates an empty, unvisited information-set node.
+ */
+func newISNode(key string) *isNode {
+	return &isNode{
+		key:      key,
+		children: make(map[string]*isNode),
+		moves:    make(map[string]Move),
+	}
+}
+
+/*
+ * nodeFor returns the node for key from nodes, creating it if this is the
+ * first time key has been reached. Every call site that might reach the same
+ * information set from a different path shares nodes, so they are handed the
+ * same *isNode rather than each growing their own.
+ */
+func nodeFor(nodes map[string]*isNode, key string) *isNode {
+	node, ok := nodes[key]
+	if !ok {
+		node = newISNode(key)
+		nodes[key] = node
+	}
+
+	return node
+}
+
+/*
+ * ucb1 scores a child using the UCB1 formula adapted for information sets,
+ * where availability A(v) counts iterations in which the move was legal
+ * (whether or not it was actually selected), rather than iterations in which
+ * the child was visited. favorable flips the sign of the exploration bonus:
+ * for the maximizing player a low-visit child should score higher (more
+ * attractive as a maximum), but for the minimizing player the same child
+ * should score lower (more attractive as a minimum) - applying the +bonus
+ * form to both, as selectOrExpand used to, pushes under-sampled opponent
+ * moves away from being selected instead of encouraging their exploration.
+ *
+ * Args:
+ *  child: The child node being scored.
+ *  c: The exploration constant.
+ *  favorable: Whether the node child belongs to is the maximizing player's
+ *             (as opposed to the minimizing opponent's).
+ *
+ * Returns:
+ *  +Inf (-Inf for the minimizing player) for a child that has never been
+ *  visited, so every legal move is tried at least once before any is
+ *  revisited.
+ */
+func ucb1(child *isNode, c float64, favorable bool) float64 {
+	if child.visits == 0 {
+		if !favorable {
+			return math.Inf(-1)
+		}
+		return math.Inf(1)
+	}
+
+	bonus := c * math.Sqrt(math.Log(child.avail)/child.visits)
+	if !favorable {
+		bonus = -bonus
+	}
+
+	return child.total/child.visits + bonus
+}
+
+/*
+ * ChildStat summarizes one root move's search statistics at the end of an
+ * ISMCTS search: how many times it was visited, and its average evaluation.
+ * ISMCTS returns one per legal root move so a caller such as
+ * player.ISMCTS.Play can log a real euchre.GameLog PlayoutChoice instead of
+ * one with always-nil visits and Q-values.
+ */
+type ChildStat struct {
+	Move   Move
+	Visits float64
+	Q      float64
+}
+
+/*
+ * ISMCTS runs Information-Set Monte Carlo Tree Search from rootInfoState for
+ * the given number of iterations and returns the move with the most visits,
+ * which is the conventional, variance-resistant choice for the final
+ * decision.
+ *
+ * Each iteration determinizes a concrete world consistent with
+ * rootInfoState, then descends the information-set tree using UCB1 (legal
+ * moves only, restricted to what is legal in the sampled world), expands one
+ * unexpanded legal child, rolls the sampled world out to a terminal state
+ * using engine's random policy, and backpropagates the result. Nodes are
+ * keyed by information set (via engine.Observe(...).Key()) rather than by
+ * move-path position, and are shared across the whole search through a
+ * single nodes table, so two different move sequences that transpose into
+ * the same information set pool their statistics instead of maintaining
+ * independent copies - this is what lets ISMCTS integrate hidden-information
+ * sampling into the tree instead of averaging independent searches.
+ *
+ * Args:
+ *  rootInfoState: The information set to search from.
+ *  engine: The information-set game logic for the search.
+ *  iterations: The number of determinize/descend/rollout/backpropagate
+ *              iterations to run.
+ *
+ * Returns:
+ *  The move judged best by visit count, its node's average evaluation, and a
+ *  ChildStat per legal root move recording search's visit count and average
+ *  evaluation for it. If rootInfoState is already terminal or iterations is
+ *  0, no children are ever expanded; the zero Move, an evaluation of 0, and
+ *  nil stats are returned in that case rather than panicking.
+ */
+func ISMCTS(rootInfoState InfoState, engine ISEngine, iterations int) (Move, float64, []ChildStat) {
+	const explorationConstant = math.Sqrt2
+
+	nodes := make(map[string]*isNode)
+	root := nodeFor(nodes, rootInfoState.Key())
+
+	for i := 0; i < iterations; i++ {
+		state := engine.Determinize(rootInfoState)
+		path := []*isNode{root}
+
+		node := root
+		for !engine.IsTerminal(state) {
+			legal := engine.Legal(state)
+			if len(legal) == 0 {
+				break
+			}
+
+			// Availability is incremented for every move that was legal at
+			// this decision point, whether or not it is ultimately chosen,
+			// since that is what distinguishes ISMCTS from plain UCT.
+			for _, move := range legal {
+				childKey := moveKey(move)
+				child, ok := node.children[childKey]
+				if !ok {
+					child = nodeFor(nodes, engine.Observe(state, move).Key())
+					node.children[childKey] = child
+					node.moves[childKey] = move
+				}
+				child.avail++
+			}
+
+			selectedKey, unexpanded := selectOrExpand(node, legal, engine.Favorable(state), explorationConstant)
+
+			move := node.moves[selectedKey]
+			state = move.State
+			node = node.children[selectedKey]
+			path = append(path, node)
+
+			if unexpanded {
+				break
+			}
+		}
+
+		evaluation := engine.Rollout(state)
+
+		for _, visited := range path {
+			visited.visits++
+			visited.total += evaluation
+		}
+	}
+
+	if len(root.children) == 0 {
+		return Move{}, 0, nil
+	}
+
+	bestKey := ""
+	bestVisits := -1.0
+	stats := make([]ChildStat, 0, len(root.children))
+	for key, child := range root.children {
+		// Ties in visit count go to the smallest key, so the choice does not
+		// depend on map iteration order.
+		if child.visits > bestVisits || (child.visits == bestVisits && key < bestKey) {
+			bestVisits = child.visits
+			bestKey = key
+		}
+
+		q := 0.0
+		if child.visits > 0 {
+			q = child.total / child.visits
+		}
+		stats = append(stats, ChildStat{Move: root.moves[key], Visits: child.visits, Q: q})
+	}
+
+	best := root.children[bestKey]
+	return root.moves[bestKey], best.total / best.visits, stats
+}
+
+/*
+ * selectOrExpand picks the next move to descend to from node, given the
+ * moves that are legal in the current determinization. If any legal move has
+ * not yet been expanded into a child, it is chosen so the tree grows by one
+ * node per iteration; otherwise the legal move with the best UCB1 score is
+ * chosen.
+ *
+ * Returns:
+ *  The key of the selected move, and whether it was a first-time expansion
+ *  (in which case the caller should stop descending after it).
+ */
+func selectOrExpand(node *isNode, legal []Move, favorable bool, c float64) (string, bool) {
+	for _, move := range legal {
+		key := moveKey(move)
+		if node.children[key].visits == 0 {
+			return key, true
+		}
+	}
+
+	bestKey := moveKey(legal[0])
+	bestScore := math.Inf(-1)
+	if !favorable {
+		bestScore = math.Inf(1)
+	}
+
+	for _, move := range legal {
+		key := moveKey(move)
+		score := ucb1(node.children[key], c, favorable)
+
+		if favorable && score > bestScore {
+			bestScore = score
+			bestKey = key
+		} else if !favorable && score < bestScore {
+			bestScore = score
+			bestKey = key
+		}
+	}
+
+	return bestKey, false
+}
+
+/*
+ * moveKey returns a stable string identifier for a move, used to key a
+ * node's children. Moves are identified by their action rather than the
+ * resulting state, since the state is different for every determinization
+ * while the action is the thing the information set tree should share.
+ */
+func moveKey(move Move) string {
+	return fmt.Sprintf("%v", move.Action)
+}
+
+/*
+ * RandomRollout is a convenience ISEngine.Rollout helper that plays state out
+ * to a terminal state by choosing uniformly among legal moves at each step,
+ * then returns the engine's evaluation of the terminal state. It is exported
+ * so ISEngine implementations can embed it instead of reimplementing a
+ * random policy.
+ *
+ * Args:
+ *  state: The concrete state to roll out from.
+ *  engine: The engine providing legal moves, termination, and evaluation.
+ *  rnd: The source of randomness to use, so rollouts can be made
+ *       reproducible by the caller.
+ *
+ * Returns:
+ *  The evaluation of the terminal state reached by the rollout.
+ */
+func RandomRollout(state TSState, engine TSEngine, rnd *rand.Rand) float64 {
+	evaluation, _ := RunPlayoutLog(state, engine, rnd)
+	return evaluation
+}
@@ -1,9 +1,14 @@
 package ai
 
-import "math"
+import (
+	"math"
+	"math/rand"
+)
 
 /*
  * Uses minimax adversarial tree search to find the optimal move in a game.
+ * Ties between equally good moves are broken by always keeping the first one
+ * encountered; use MinimaxWithRand for reproducible random tie-breaking.
  *
  * Args:
  *  state: The state to start the search from.
@@ -15,18 +20,43 @@ import "math"
  *  and the state it will send you to.
  */
 func Minimax(state TSState, engine TSEngine) (float64, Move) {
-	return minimaxHelper(state, engine, math.Inf(-1), math.Inf(1))
+	return MinimaxWithRand(state, engine, nil)
+}
+
+/*
+ * MinimaxWithRand runs the same search as Minimax, except that when rnd is
+ * non-nil, ties between equally good moves are broken uniformly at random
+ * using rnd instead of always keeping the first move found. Passing a nil
+ * rnd reproduces Minimax's behavior exactly, which is what Minimax itself
+ * does.
+ *
+ * Args:
+ *  state: The state to start the search from.
+ *  engine: The game logic engine for the tree search.
+ *  rnd: The source of randomness for tie-breaking, or nil to keep the first
+ *       move found on a tie.
+ *
+ * Returns:
+ *  Gives both the evaluation for the best state and the Move struct associated
+ *  with it. This move struct provides the action needed to get to this state
+ *  and the state it will send you to.
+ */
+func MinimaxWithRand(state TSState, engine TSEngine, rnd *rand.Rand) (float64, Move) {
+	return minimaxHelper(state, engine, math.Inf(-1), math.Inf(1), rnd)
 }
 
 /*
  * Finds the best move and its evaluation using minimax adversarial search and
- * alpha-beta pruning. This is a helper method used privately by Minimax.
+ * alpha-beta pruning. This is a helper method used privately by Minimax and
+ * MinimaxWithRand.
  *
  * Args:
  *  state: The state to start the search from.
  *  engine: The logic engine for the tree search.
  *  alpha: The current alpha value. This should be set to -inf when first called.
  *  beta: The current beta value. This should be set to +inf when first called.
+ *  rnd: The source of randomness for tie-breaking, or nil to keep the first
+ *       move found on a tie.
  *
  * Returns:
  *  Gives both the evaluation for the best state and the Move struct associated
@@ -34,7 +64,7 @@ func Minimax(state TSState, engine TSEngine) (float64, Move) {
  *  and the state it will send you to.
  */
 func minimaxHelper(state TSState, engine TSEngine, alpha float64,
-	beta float64) (float64, Move) {
+	beta float64, rnd *rand.Rand) (float64, Move) {
 	if engine.IsTerminal(state) {
 		return engine.Evaluation(state), Move{nil, state}
 	}
@@ -49,14 +79,21 @@ func minimaxHelper(state TSState, engine TSEngine, alpha float64,
 		extremeValue = math.Inf(1)
 	}
 
+	ties := 0
 	for _, nextMove := range engine.Successors(state) {
 		nextState := nextMove.State
-		nextEval, _ := minimaxHelper(nextState, engine, alpha, beta)
+		nextEval, _ := minimaxHelper(nextState, engine, alpha, beta, rnd)
 
 		if fav {
 			if nextEval > extremeValue {
 				extremeValue = nextEval
 				extremeMove = nextMove
+				ties = 1
+			} else if nextEval == extremeValue && rnd != nil {
+				ties++
+				if rnd.Intn(ties) == 0 {
+					extremeMove = nextMove
+				}
 			}
 
 			alpha = math.Max(alpha, nextEval)
@@ -64,6 +101,12 @@ func minimaxHelper(state TSState, engine TSEngine, alpha float64,
 			if nextEval < extremeValue {
 				extremeValue = nextEval
 				extremeMove = nextMove
+				ties = 1
+			} else if nextEval == extremeValue && rnd != nil {
+				ties++
+				if rnd.Intn(ties) == 0 {
+					extremeMove = nextMove
+				}
 			}
 
 			beta = math.Min(beta, nextEval)
@@ -0,0 +1,47 @@
+package ai
+
+import "math/rand"
+
+/*
+ * PlayoutStep records one ply of a random playout: the state it was taken
+ * from, every move that was legal there, and which of them was actually
+ * chosen. Recording this structurally, instead of only printing it, is what
+ * lets a test assert on a playout's shape directly.
+ */
+type PlayoutStep struct {
+	State      TSState
+	Candidates []Move
+	Chosen     Move
+}
+
+/*
+ * RunPlayoutLog plays state out to a terminal state by choosing uniformly
+ * among legal moves at each step, exactly like RandomRollout, but returns a
+ * structured, ply-by-ply record of the playout instead of discarding it.
+ *
+ * Args:
+ *  state: The state to play out from.
+ *  engine: The engine providing legal moves, termination, and evaluation.
+ *  rnd: The source of randomness to use, so a playout can be replayed
+ *       bit-exactly by reusing the same seed.
+ *
+ * Returns:
+ *  The evaluation of the terminal state reached, and the steps taken to
+ *  reach it.
+ */
+func RunPlayoutLog(state TSState, engine TSEngine, rnd *rand.Rand) (float64, []PlayoutStep) {
+	var steps []PlayoutStep
+
+	for !engine.IsTerminal(state) {
+		candidates := engine.Successors(state)
+		if len(candidates) == 0 {
+			break
+		}
+
+		chosen := candidates[rnd.Intn(len(candidates))]
+		steps = append(steps, PlayoutStep{State: state, Candidates: candidates, Chosen: chosen})
+		state = chosen.State
+	}
+
+	return engine.Evaluation(state), steps
+}
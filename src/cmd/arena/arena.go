@@ -0,0 +1,42 @@
+package main
+
+import (
+	"eval"
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+)
+
+func main() {
+	roster := flag.String("roster", "", "path to a JSON roster file")
+	seed := flag.Int64("seed", 1, "seed for the tournament's RNG")
+	workers := flag.Int("workers", 1, "number of pairings to run concurrently")
+	logPath := flag.String("log", "", "optional JSONL file to append match results to")
+	flag.Parse()
+
+	if *roster == "" {
+		fmt.Fprintf(os.Stderr, "usage: arena -roster roster.json [-seed 1] [-workers 1] [-log results.jsonl]\n")
+		os.Exit(1)
+	}
+
+	rosters, games, err := eval.LoadRoster(*roster)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "arena: %s\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Running %d-game round robin between %d players...\n", games, len(rosters))
+
+	tourney := eval.NewTournament(rosters, games, rand.New(rand.NewSource(*seed)))
+	results := tourney.RunParallel(*workers)
+
+	fmt.Print(tourney.Summary())
+
+	if *logPath != "" {
+		if err := eval.WriteLog(*logPath, results); err != nil {
+			fmt.Fprintf(os.Stderr, "arena: writing log: %s\n", err)
+			os.Exit(1)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"euchre"
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	path := flag.String("log", "", "path to a JSON-encoded euchre.GameLog")
+	flag.Parse()
+
+	if *path == "" {
+		fmt.Fprintf(os.Stderr, "usage: replay -log hand.json\n")
+		os.Exit(1)
+	}
+
+	file, err := os.Open(*path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "replay: %s\n", err)
+		os.Exit(1)
+	}
+	defer file.Close()
+
+	var log euchre.GameLog
+	if err := json.NewDecoder(file).Decode(&log); err != nil {
+		fmt.Fprintf(os.Stderr, "replay: %s\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Loaded %d events. Press enter to step through each one.\n", len(log.Events))
+
+	stdin := bufio.NewReader(os.Stdin)
+	for i, event := range log.Events {
+		fmt.Printf("\n[%d/%d] %s\n", i+1, len(log.Events), describe(event))
+		stdin.ReadString('\n')
+	}
+}
+
+/*
+ * describe renders a single Event as a human-readable line, showing the
+ * candidates' visit counts and Q-values for a search-backed
+ * EventPlayoutChoice rather than just the move that was chosen.
+ */
+func describe(event euchre.Event) string {
+	switch event.Type {
+	case euchre.EventDeal:
+		return fmt.Sprintf("deal: %v", event.Hands)
+
+	case euchre.EventTopCard:
+		return fmt.Sprintf("top card: %s", event.Card)
+
+	case euchre.EventBid:
+		alone := ""
+		if event.Alone {
+			alone = " (alone)"
+		}
+		return fmt.Sprintf("player %d calls %s%s", event.Player, event.Trump, alone)
+
+	case euchre.EventDiscard:
+		return fmt.Sprintf("player %d discards %s", event.Player, event.Card)
+
+	case euchre.EventPlay:
+		return fmt.Sprintf("player %d plays %s", event.Player, event.Card)
+
+	case euchre.EventTrickWinner:
+		return fmt.Sprintf("player %d wins the trick", event.Player)
+
+	case euchre.EventPlayoutChoice:
+		line := fmt.Sprintf("player %d chooses %s from %v", event.Player, event.Card, event.Candidates)
+		for _, candidate := range event.Candidates {
+			line += fmt.Sprintf("\n    %s: visits=%d q=%.3f",
+				candidate, event.Visits[candidate], event.QValues[candidate])
+		}
+		return line
+	}
+
+	return "unknown event"
+}
@@ -0,0 +1,98 @@
+package deck
+
+/*
+ * maskedSuit and maskedValue are sentinels that mark a Card as masked: a
+ * placeholder for a card whose identity is hidden from whoever is holding
+ * the slice it's in (an opponent's hand during search, for example), rather
+ * than a real playable card. They intentionally fall outside every suit and
+ * value iota range, so a masked Card can never be confused with Card{},
+ * which used to be (incorrectly) overloaded to mean "unknown".
+ */
+const (
+	maskedSuit  Suit  = -1
+	maskedValue Value = -1
+)
+
+/*
+ * NewMasked returns a Card that represents an unknown/hidden card: one whose
+ * existence is known (it occupies a slot in a hand) but whose suit and value
+ * are not. Search code that builds an information state, rather than a
+ * concrete determinization, uses this instead of Card{} to stand in for
+ * opponents' cards.
+ */
+func NewMasked() Card {
+	return Card{maskedSuit, maskedValue}
+}
+
+/*
+ * IsMasked returns whether c is a masked placeholder rather than a real
+ * card.
+ */
+func (c Card) IsMasked() bool {
+	return c.Suit == maskedSuit || c.Value == maskedValue
+}
+
+/*
+ * AdjSuitOk is the masked-safe counterpart to AdjSuit: it returns false
+ * instead of a nonsensical suit when c is masked, so callers walking a hand
+ * that may contain hidden cards (an opponent's hand in an information state)
+ * can skip them instead of silently treating a masked card as some real
+ * suit.
+ *
+ * Args:
+ *  trump: The trump suit to adjust for.
+ *
+ * Returns:
+ *  c's adjusted suit and true, or an unspecified Suit and false if c is
+ *  masked.
+ */
+func (c Card) AdjSuitOk(trump Suit) (Suit, bool) {
+	if c.IsMasked() {
+		return Suit(0), false
+	}
+
+	return c.AdjSuit(trump), true
+}
+
+/*
+ * ValueOk is the masked-safe counterpart to reading c.Value directly: it
+ * returns false instead of Value(0) when c is masked, so "I don't know this
+ * card's value" can't be confused with "this card's value is the zero
+ * value".
+ *
+ * Returns:
+ *  c's value and true, or Value(0) and false if c is masked.
+ */
+func (c Card) ValueOk() (Value, bool) {
+	if c.IsMasked() {
+		return Value(0), false
+	}
+
+	return c.Value, true
+}
+
+/*
+ * MustAdjSuit is the panicking counterpart to AdjSuitOk, for call sites that
+ * have already established (or require) that c is not masked, such as
+ * resolving a concrete determinization where every card must be known.
+ */
+func (c Card) MustAdjSuit(trump Suit) Suit {
+	suit, ok := c.AdjSuitOk(trump)
+	if !ok {
+		panic("deck: MustAdjSuit called on a masked card")
+	}
+
+	return suit
+}
+
+/*
+ * MustValue is the panicking counterpart to ValueOk.
+ */
+func (c Card) MustValue() Value {
+	value, ok := c.ValueOk()
+	if !ok {
+		panic("deck: MustValue called on a masked card")
+	}
+
+	return value
+}
@@ -0,0 +1,78 @@
+package deck
+
+import "testing"
+
+/*
+ * TestNewMaskedIsMasked checks that a freshly built masked card reports
+ * itself as masked, and that an ordinary card does not.
+ */
+func TestNewMaskedIsMasked(t *testing.T) {
+	if !NewMasked().IsMasked() {
+		t.Errorf("expected NewMasked() to be masked")
+	}
+
+	real := Card{Suit: D, Value: Nine}
+	if real.IsMasked() {
+		t.Errorf("expected an ordinary card to not be masked")
+	}
+}
+
+/*
+ * TestAdjSuitOkMasked checks that AdjSuitOk reports false for a masked
+ * card instead of returning a nonsensical suit.
+ */
+func TestAdjSuitOkMasked(t *testing.T) {
+	if _, ok := NewMasked().AdjSuitOk(D); ok {
+		t.Errorf("expected AdjSuitOk to report false for a masked card")
+	}
+
+	if _, ok := (Card{Suit: D, Value: Nine}).AdjSuitOk(D); !ok {
+		t.Errorf("expected AdjSuitOk to report true for an ordinary card")
+	}
+}
+
+/*
+ * TestValueOkMasked checks that ValueOk reports false for a masked card
+ * instead of returning the zero Value.
+ */
+func TestValueOkMasked(t *testing.T) {
+	if _, ok := NewMasked().ValueOk(); ok {
+		t.Errorf("expected ValueOk to report false for a masked card")
+	}
+
+	value, ok := (Card{Suit: D, Value: Nine}).ValueOk()
+	if !ok {
+		t.Errorf("expected ValueOk to report true for an ordinary card")
+	}
+	if value != Nine {
+		t.Errorf("expected ValueOk to return Nine, got %v", value)
+	}
+}
+
+/*
+ * TestMustAdjSuitPanicsOnMasked checks that MustAdjSuit panics rather than
+ * silently returning a nonsensical suit for a masked card.
+ */
+func TestMustAdjSuitPanicsOnMasked(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected MustAdjSuit to panic on a masked card")
+		}
+	}()
+
+	NewMasked().MustAdjSuit(D)
+}
+
+/*
+ * TestMustValuePanicsOnMasked checks that MustValue panics rather than
+ * silently returning the zero Value for a masked card.
+ */
+func TestMustValuePanicsOnMasked(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected MustValue to panic on a masked card")
+		}
+	}()
+
+	NewMasked().MustValue()
+}
@@ -0,0 +1,21 @@
+package deck
+
+import "math/rand"
+
+/*
+ * ShuffleWithRand shuffles cards in place using the Fisher-Yates algorithm,
+ * drawing all of its randomness from rnd rather than the global math/rand
+ * source that Shuffle uses. This is what lets a failing test or a
+ * tournament run in the eval package be replayed bit-exactly: reuse the same
+ * seed and the same deal comes out.
+ *
+ * Args:
+ *  cards: The cards to shuffle in place.
+ *  rnd: The source of randomness to shuffle with.
+ */
+func ShuffleWithRand(cards []Card, rnd *rand.Rand) {
+	for i := len(cards) - 1; i > 0; i-- {
+		j := rnd.Intn(i + 1)
+		cards[i], cards[j] = cards[j], cards[i]
+	}
+}
@@ -1,8 +1,8 @@
 package euchre
 
 import (
-    "ai"
     "deck"
+    "math/rand"
     "testing"
 )
 
@@ -66,9 +66,79 @@ func TestRunPlayout(t *testing.T) {
     var prior []Trick
 
     s := NewState(setup, 0, hand, played, prior, deck.Card{ })
-    n := ai.NewNode()
-    n.Value(s)
     e := Engine{ }
 
-    ai.RunPlayoutDebug(n, e)
+    /*
+     * euchre.RunPlayoutDebug runs the same kind of random playout, but
+     * returns a structured GameLog instead of only printing one, so the
+     * outcome can be asserted on directly rather than eyeballed from stdout.
+     */
+    evaluation, log := RunPlayoutDebug(s, e, rand.New(rand.NewSource(1)))
+    if len(log.Events) == 0 {
+        t.Errorf("Expected at least one logged event, got none.\n")
+    }
+    for i, event := range log.Events {
+        if event.Type != EventPlayoutChoice {
+            t.Errorf("Event %d had unexpected type %v.\n", i, event.Type)
+        }
+        if len(event.Candidates) == 0 {
+            t.Errorf("Event %d had no candidate moves.\n", i)
+        }
+    }
+    t.Logf("Playout reached evaluation %v over %d events.\n", evaluation, len(log.Events))
+}
+
+/*
+ * Tests that Replay reconstructs the State a logged hand ends in, including
+ * when the log ends mid-trick, where the next player to act is not
+ * necessarily whoever led the trick.
+ *
+ * Args:
+ *  t - The testing context.
+ */
+func TestGameLogReplay(t *testing.T) {
+    hands := [4][]deck.Card{
+        {deck.Card{deck.H, deck.Nine}, deck.Card{deck.H, deck.Ten}},
+        {deck.Card{deck.S, deck.A}, deck.Card{deck.S, deck.K}},
+        {deck.Card{deck.D, deck.Q}, deck.Card{deck.D, deck.K}},
+        {deck.Card{deck.C, deck.Q}, deck.Card{deck.C, deck.K}},
+    }
+
+    setup := Setup{
+        1,
+        1,
+        true,
+        deck.Card{deck.D, deck.Nine},
+        deck.D,
+        deck.Card{},
+    }
+
+    var log GameLog
+    log.Deal(setup, hands)
+    log.TopCard(deck.Card{deck.D, deck.Nine})
+    log.Bid(1, deck.D, false)
+
+    // Player 0 leads, then player 1 plays, leaving the trick with player 2
+    // on move - not the leader, and not player 1 either.
+    log.Play(0, deck.Card{deck.H, deck.Nine})
+    log.Play(1, deck.Card{deck.S, deck.A})
+
+    state := Replay(log)
+
+    if state.Player != 2 {
+        t.Errorf("Expected player 2 to be on move, got %d.\n", state.Player)
+    }
+    // Player 2 has not played yet, so both of their dealt cards remain.
+    hand := state.Hands[state.Player]
+    want := []deck.Card{deck.Card{deck.D, deck.Q}, deck.Card{deck.D, deck.K}}
+    if len(hand) != len(want) || hand[0] != want[0] || hand[1] != want[1] {
+        t.Errorf("Expected player 2's remaining hand to be %v, got %v.\n",
+            want, hand)
+    }
+    if state.Trump != deck.D {
+        t.Errorf("Expected trump %s, got %s.\n", deck.D, state.Trump)
+    }
+    if len(state.Played) != 2 {
+        t.Errorf("Expected 2 cards played to the current trick, got %d.\n", len(state.Played))
+    }
 }
\ No newline at end of file
@@ -0,0 +1,224 @@
+package euchre
+
+import "deck"
+
+/*
+ * EventType identifies what kind of thing happened at one point in a hand.
+ */
+type EventType int
+
+const (
+	EventDeal EventType = iota
+	EventTopCard
+	EventBid
+	EventDiscard
+	EventPlay
+	EventTrickWinner
+	EventPlayoutChoice
+)
+
+/*
+ * Event is a single typed record in a GameLog. Only the fields relevant to
+ * Type are populated; the rest are left at their zero value. This is
+ * deliberately one flat struct rather than one type per EventType, so a
+ * GameLog can be serialized and stepped through generically without a type
+ * switch at the (de)serialization boundary - cmd/replay only needs one.
+ */
+type Event struct {
+	Type EventType
+
+	// Player is who the event concerns: who bid, who discarded, who played
+	// or chose a card, or who won a trick. It is left at 0 for EventDeal and
+	// EventTopCard.
+	Player int
+
+	// Card is the single card relevant to EventTopCard, EventDiscard,
+	// EventPlay, and EventPlayoutChoice (the card actually chosen).
+	Card deck.Card
+
+	// Hands and Setup are populated only for EventDeal: the four players'
+	// dealt hands, and the hand's dealer/caller context.
+	Hands [4][]deck.Card
+	Setup Setup
+
+	// Trump and Alone are populated only for EventBid.
+	Trump deck.Suit
+	Alone bool
+
+	// Candidates, Visits, and QValues are populated only for
+	// EventPlayoutChoice: every card that was legal, and (when the move was
+	// chosen by search rather than at random) each candidate's visit count
+	// and average evaluation.
+	Candidates []deck.Card
+	Visits     map[deck.Card]int
+	QValues    map[deck.Card]float64
+}
+
+/*
+ * GameLog is an ordered, structured record of everything that happened
+ * during a hand: the deal, the turned-up card, the bidding, any discard,
+ * every card played, every trick's winner, and (for search-backed players)
+ * the candidate evaluations behind each decision. It exists so a hand can be
+ * persisted, pretty-printed, stepped through by cmd/replay, or asserted on
+ * directly in a test, instead of only being inspected by eyeballing stdout.
+ */
+type GameLog struct {
+	Events []Event
+}
+
+/*
+ * Record appends event to the log.
+ */
+func (log *GameLog) Record(event Event) {
+	log.Events = append(log.Events, event)
+}
+
+/*
+ * Deal records the four hands dealt at the start of a hand, and the setup
+ * (dealer, trump caller, etc.) that hand is played under.
+ */
+func (log *GameLog) Deal(setup Setup, hands [4][]deck.Card) {
+	log.Record(Event{Type: EventDeal, Setup: setup, Hands: hands})
+}
+
+/*
+ * TopCard records the card turned up for the first round of bidding.
+ */
+func (log *GameLog) TopCard(card deck.Card) {
+	log.Record(Event{Type: EventTopCard, Card: card})
+}
+
+/*
+ * Bid records a player naming trump, whether by ordering up the top card or
+ * calling in the second round, and whether they went alone.
+ */
+func (log *GameLog) Bid(player int, trump deck.Suit, alone bool) {
+	log.Record(Event{Type: EventBid, Player: player, Trump: trump, Alone: alone})
+}
+
+/*
+ * Discard records the card the dealer buried after picking up the top card.
+ */
+func (log *GameLog) Discard(player int, card deck.Card) {
+	log.Record(Event{Type: EventDiscard, Player: player, Card: card})
+}
+
+/*
+ * Play records a card a player played to a trick.
+ */
+func (log *GameLog) Play(player int, card deck.Card) {
+	log.Record(Event{Type: EventPlay, Player: player, Card: card})
+}
+
+/*
+ * TrickWinner records who won the trick most recently played to.
+ */
+func (log *GameLog) TrickWinner(player int) {
+	log.Record(Event{Type: EventTrickWinner, Player: player})
+}
+
+/*
+ * PlayoutChoice records a search-backed decision: every card that was
+ * legal, the visit counts and average evaluations search assigned to each
+ * (as ai.ISMCTS returns), and which one was ultimately chosen.
+ */
+func (log *GameLog) PlayoutChoice(player int, chosen deck.Card, candidates []deck.Card,
+	visits map[deck.Card]int, qValues map[deck.Card]float64) {
+	log.Record(Event{
+		Type:       EventPlayoutChoice,
+		Player:     player,
+		Card:       chosen,
+		Candidates: candidates,
+		Visits:     visits,
+		QValues:    qValues,
+	})
+}
+
+/*
+ * Replay reconstructs the State that a logged hand ends in by folding its
+ * events through the same constructors a live game uses, so a persisted
+ * GameLog can be inspected or re-searched from its final position instead of
+ * only re-read as text.
+ *
+ * Args:
+ *  log: The GameLog to replay.
+ *
+ * Returns:
+ *  The State the hand had reached after its last recorded event.
+ */
+func Replay(log GameLog) State {
+	var setup Setup
+	var hands [4][]deck.Card
+	var played []deck.Card
+	var prior []Trick
+	var trump deck.Suit
+	var top deck.Card
+	var alone = -1
+	leader := 0
+
+	for _, event := range log.Events {
+		switch event.Type {
+		case EventDeal:
+			setup = event.Setup
+			hands = event.Hands
+
+		case EventTopCard:
+			top = event.Card
+
+		case EventBid:
+			trump = event.Trump
+			if event.Alone {
+				alone = event.Player
+			}
+
+		case EventDiscard:
+			hands[event.Player] = removeCard(hands[event.Player], event.Card)
+
+		case EventPlay:
+			hands[event.Player] = removeCard(hands[event.Player], event.Card)
+			played = append(played, event.Card)
+
+		case EventTrickWinner:
+			prior = append(prior, Trick{Cards: played, Led: leader, Alone: alone})
+			played = nil
+			leader = event.Player
+		}
+	}
+
+	actor := actingPlayer(leader, len(played), alone)
+	return NewState(setup, actor, hands[actor], played, prior, top)
+}
+
+/*
+ * actingPlayer returns who is on move in the current trick, given who led it
+ * (leader), how many cards have been played to it so far, and who, if
+ * anyone, is sitting out because their partner is going alone. It is the
+ * forward-direction counterpart to Leader/LeaderInclusive in logic.go: those
+ * walk backward from the player on move to find who led, while actingPlayer
+ * walks forward from the leader to find who is on move, skipping the
+ * sitting-out partner the same way noSuits and Winner do.
+ */
+func actingPlayer(leader, played, alone int) int {
+	player := leader
+	for i := 0; i < played; i++ {
+		player = (player + 1) % 4
+		if alone >= 0 && player == (alone+2)%4 {
+			player = (player + 1) % 4
+		}
+	}
+
+	return player
+}
+
+/*
+ * removeCard returns hand with the first occurrence of card removed.
+ */
+func removeCard(hand []deck.Card, card deck.Card) []deck.Card {
+	for i, c := range hand {
+		if c == card {
+			return append(hand[:i], hand[i+1:]...)
+		}
+	}
+
+	return hand
+}
@@ -0,0 +1,222 @@
+package euchre
+
+import (
+	"ai"
+	"deck"
+	"fmt"
+	"math/rand"
+)
+
+/*
+ * InfoState is the information available to a single player at a point in a
+ * hand: their own cards and everything that has been observed publicly, but
+ * none of the other players' hidden hands. It is what ai.ISMCTS searches
+ * over, as opposed to the fully observable State that Engine/Minimax use
+ * once a concrete world has been sampled.
+ *
+ * Hands holds all four players' hands, but only Hands[Player] is real cards;
+ * the other three are padded with deck.NewMasked() placeholders, one per
+ * card the opponent is still believed to hold. This gives every search
+ * algorithm a single, canonical information-state shape to work from instead
+ * of each one inventing its own "opponent hands aren't materialized yet"
+ * convention. Voids records the suits each player is already known not to
+ * hold, as computed by noSuits from the tricks played so far.
+ */
+type InfoState struct {
+	Setup  Setup
+	Player int
+	Hands  [4][]deck.Card
+	Played []deck.Card
+	Prior  []Trick
+	Trump  deck.Suit
+	Voids  map[int][]deck.Suit
+}
+
+/*
+ * NewInfoState builds the InfoState a player at the table actually observes:
+ * their own hand verbatim, and masked placeholders standing in for every
+ * other player's remaining cards.
+ *
+ * Args:
+ *  setup: The setup of the current hand.
+ *  player: The acting player's number.
+ *  hand: The acting player's current cards.
+ *  played: The cards played so far in the current trick.
+ *  prior: The tricks completed earlier in the hand.
+ *  trump: The current trump suit.
+ *
+ * Returns:
+ *  An InfoState with player's hand known and every other hand masked.
+ */
+func NewInfoState(setup Setup, player int, hand, played []deck.Card,
+	prior []Trick, trump deck.Suit) InfoState {
+	var hands [4][]deck.Card
+	hands[player] = hand
+
+	// Every player who hasn't sat out still holds as many cards as the
+	// acting player does at this point in the hand, so that count is used
+	// to size their masked placeholder hand.
+	for _, other := range otherPlayers(player) {
+		masked := make([]deck.Card, len(hand))
+		for i := range masked {
+			masked[i] = deck.NewMasked()
+		}
+		hands[other] = masked
+	}
+
+	return InfoState{
+		Setup:  setup,
+		Player: player,
+		Hands:  hands,
+		Played: played,
+		Prior:  prior,
+		Trump:  trump,
+		Voids:  noSuits(prior, trump),
+	}
+}
+
+/*
+ * Key returns a string that identifies this information set: the acting
+ * player, their hand, and the sequence of tricks and cards played so far.
+ * Two InfoStates with the same Key have seen the same history and so should
+ * share the same node in the ISMCTS tree, regardless of how the hidden cards
+ * happen to be arranged in any one determinization. Opponents' masked hands
+ * are deliberately left out of the key, since every determinization of the
+ * same observed history has the same number of masked cards anyway.
+ */
+func (s InfoState) Key() string {
+	return fmt.Sprintf("%d|%v|%v|%v|%v", s.Player, s.Hands[s.Player], s.Played, s.Prior, s.Trump)
+}
+
+/*
+ * ISEngine adapts the euchre package's existing TSEngine (Engine) to
+ * ai.ISEngine, so ISMCTS can search a hand without being handed the
+ * opponents' concrete cards up front.
+ */
+type ISEngine struct {
+	engine Engine
+	rnd    *rand.Rand
+	model  OpponentModel
+}
+
+/*
+ * NewISEngine builds an ISEngine that determinizes by sampling each
+ * opponent's hand uniformly at random, using rnd, so that searches started
+ * from the same InfoState and rnd seed are reproducible.
+ *
+ * Args:
+ *  rnd: The source of randomness used to sample determinizations and to
+ *       drive random rollouts.
+ *
+ * Returns:
+ *  An ISEngine ready to be passed to ai.ISMCTS.
+ */
+func NewISEngine(rnd *rand.Rand) ISEngine {
+	return NewISEngineWithModel(rnd, uniformModel{rnd})
+}
+
+/*
+ * NewISEngineWithModel builds an ISEngine that determinizes opponents' hands
+ * using model instead of assuming they are uniformly likely, so that
+ * ISMCTS/PIMC draws its determinizations from (for example) a posterior
+ * conditioned on observed bidding behavior.
+ *
+ * Args:
+ *  rnd: The source of randomness used to drive random rollouts.
+ *  model: The OpponentModel used to sample each opponent's hand.
+ *
+ * Returns:
+ *  An ISEngine ready to be passed to ai.ISMCTS.
+ */
+func NewISEngineWithModel(rnd *rand.Rand, model OpponentModel) ISEngine {
+	return ISEngine{engine: Engine{}, rnd: rnd, model: model}
+}
+
+/*
+ * Determinize samples a concrete State consistent with infoState: the acting
+ * player's hand is kept as observed, and every masked card in the other
+ * three players' hands is resolved by e.model, one opponent at a time, with
+ * each opponent's resolved hand folded back into the InfoState before the
+ * next opponent is sampled so no two opponents can end up holding the same
+ * card.
+ */
+func (e ISEngine) Determinize(infoState InfoState) ai.TSState {
+	hands := infoState.Hands
+
+	for _, player := range otherPlayers(infoState.Player) {
+		hands[player] = e.model.SampleHand(player, infoState)
+		infoState.Hands = hands
+	}
+
+	return NewState(infoState.Setup, infoState.Player, hands[infoState.Player],
+		infoState.Played, infoState.Prior, deck.NewMasked())
+}
+
+/*
+ * otherPlayers returns the three player numbers other than player, in
+ * turn order starting just after player.
+ */
+func otherPlayers(player int) []int {
+	others := make([]int, 0, 3)
+	for i := 1; i < 4; i++ {
+		others = append(others, (player+i)%4)
+	}
+
+	return others
+}
+
+/*
+ * voided returns whether card's adjusted suit is one that player is already
+ * known not to hold, given the suits collected by noSuits.
+ */
+func voided(card deck.Card, voidSuits []deck.Suit, trump deck.Suit) bool {
+	for _, suit := range voidSuits {
+		if card.AdjSuit(trump) == suit {
+			return true
+		}
+	}
+
+	return false
+}
+
+/*
+ * Legal returns the legal moves from a concrete, determinized state by
+ * delegating to the existing Engine.Successors.
+ */
+func (e ISEngine) Legal(state ai.TSState) []ai.Move {
+	return e.engine.Successors(state)
+}
+
+/*
+ * Observe returns the InfoState that results from taking move from state, as
+ * seen by the player on move in the resulting state: that player's own hand
+ * is kept and every other hand is masked.
+ */
+func (e ISEngine) Observe(state ai.TSState, move ai.Move) ai.InfoState {
+	next := move.State.(State)
+	return NewInfoState(next.Setup, next.Player, next.Hands[next.Player],
+		next.Played, next.Prior, next.Trump)
+}
+
+/*
+ * IsTerminal delegates to the existing Engine.IsTerminal.
+ */
+func (e ISEngine) IsTerminal(state ai.TSState) bool {
+	return e.engine.IsTerminal(state)
+}
+
+/*
+ * Favorable delegates to the existing Engine.Favorable.
+ */
+func (e ISEngine) Favorable(state ai.TSState) bool {
+	return e.engine.Favorable(state)
+}
+
+/*
+ * Rollout plays state out to a terminal state using ai.RandomRollout and the
+ * engine's own TSEngine behavior, so ISMCTS and PIMC-style playouts share the
+ * same random policy.
+ */
+func (e ISEngine) Rollout(state ai.TSState) float64 {
+	return ai.RandomRollout(state, e.engine, e.rnd)
+}
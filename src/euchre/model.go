@@ -0,0 +1,115 @@
+package euchre
+
+import "deck"
+
+/*
+ * OpponentModel samples a concrete hand for player, consistent with
+ * observation (the acting player's own information state, including the
+ * voids already inferred by noSuits). ISEngine.Determinize calls one of
+ * these once per opponent, feeding back each resolved hand into
+ * observation.Hands before sampling the next opponent, so later calls never
+ * deal a card that an earlier call already gave to someone else.
+ *
+ * The default, used when an ISEngine is built with NewISEngine, samples
+ * uniformly among whatever cards are still unseen. Biased models that weigh
+ * samples by observed bidding behavior live in the model package.
+ */
+type OpponentModel interface {
+	SampleHand(player int, observation InfoState) []deck.Card
+}
+
+/*
+ * Voided returns whether card's adjusted suit is one that player is already
+ * known not to hold, given the suits collected by noSuits. It is exported
+ * so OpponentModel implementations outside this package (see the model
+ * package) can respect the same voids euchre's own sampling does.
+ */
+func Voided(card deck.Card, voidSuits []deck.Suit, trump deck.Suit) bool {
+	return voided(card, voidSuits, trump)
+}
+
+/*
+ * uniformModel is ISEngine's built-in OpponentModel: every unseen card is
+ * equally likely to be in any voidless opponent's hand. It is unexported
+ * because callers who want the richer, reusable version (or the
+ * posterior-weighted BayesianBidModel) should use the model package instead;
+ * this copy exists only so a bare NewISEngine has sane default behavior.
+ */
+type uniformModel struct {
+	rnd randIntn
+}
+
+/*
+ * randIntn is the one method ISEngine's default model needs from
+ * *rand.Rand, kept as a tiny interface so this file does not need to import
+ * math/rand just to name the parameter type.
+ */
+type randIntn interface {
+	Intn(n int) int
+}
+
+/*
+ * SampleHand fills player's masked slots in observation with unseen cards,
+ * chosen uniformly at random subject to observation.Voids[player].
+ */
+func (m uniformModel) SampleHand(player int, observation InfoState) []deck.Card {
+	unseen := UnseenCards(observation)
+	hand := make([]deck.Card, len(observation.Hands[player]))
+
+	for i := range hand {
+		candidates := make([]deck.Card, 0, len(unseen))
+		for _, card := range unseen {
+			if !voided(card, observation.Voids[player], observation.Trump) {
+				candidates = append(candidates, card)
+			}
+		}
+
+		// A player can be void in every suit still present in unseen (e.g.
+		// late in a hand once several suits are exhausted), in which case
+		// the voids recorded on observation must be stale - fall back to
+		// the full unseen pool rather than spin forever looking for a card
+		// that does not exist.
+		if len(candidates) == 0 {
+			candidates = unseen
+		}
+
+		card := candidates[m.rnd.Intn(len(candidates))]
+		hand[i] = card
+		unseen = removeCard(unseen, card)
+	}
+
+	return hand
+}
+
+/*
+ * UnseenCards returns every card not already accounted for by observation:
+ * not in a known (non-masked) hand, not already played this trick, and not
+ * part of any prior trick.
+ */
+func UnseenCards(observation InfoState) []deck.Card {
+	seen := make(map[deck.Card]bool)
+	for _, hand := range observation.Hands {
+		for _, card := range hand {
+			if !card.IsMasked() {
+				seen[card] = true
+			}
+		}
+	}
+	for _, card := range observation.Played {
+		seen[card] = true
+	}
+	for _, trick := range observation.Prior {
+		for _, card := range trick.Cards {
+			seen[card] = true
+		}
+	}
+
+	unseen := make([]deck.Card, 0, 24)
+	for _, card := range deck.FullDeck() {
+		if !seen[card] {
+			unseen = append(unseen, card)
+		}
+	}
+
+	return unseen
+}
@@ -0,0 +1,63 @@
+package euchre
+
+import (
+	"deck"
+	"math/rand"
+	"testing"
+)
+
+/*
+ * allCardsExcept returns every standard euchre card except excluded, so a
+ * test can pin exactly which cards UnseenCards will report as still unseen
+ * without depending on deck.FullDeck's internal ordering.
+ */
+func allCardsExcept(excluded ...deck.Card) []deck.Card {
+	skip := make(map[deck.Card]bool, len(excluded))
+	for _, card := range excluded {
+		skip[card] = true
+	}
+
+	var cards []deck.Card
+	for _, suit := range []deck.Suit{deck.D, deck.S, deck.H, deck.C} {
+		for _, value := range []deck.Value{deck.Nine, deck.Ten, deck.J, deck.Q, deck.K, deck.A} {
+			card := deck.Card{Suit: suit, Value: value}
+			if !skip[card] {
+				cards = append(cards, card)
+			}
+		}
+	}
+
+	return cards
+}
+
+/*
+ * TestUniformModelSampleHandAllVoidedFallsBack is a regression test for the
+ * hang uniformModel.SampleHand used to hit when every unseen card fell in a
+ * suit player was already recorded void in: its rejection-sampling loop
+ * never found a card to accept and never shrank unseen. It should instead
+ * fall back to the full unseen pool and return a valid card.
+ */
+func TestUniformModelSampleHandAllVoidedFallsBack(t *testing.T) {
+	jackOfDiamonds := deck.Card{Suit: deck.D, Value: deck.J}
+	nineOfDiamonds := deck.Card{Suit: deck.D, Value: deck.Nine}
+
+	observation := InfoState{
+		Player: 0,
+		Trump:  deck.S,
+		Hands: [4][]deck.Card{
+			0: allCardsExcept(jackOfDiamonds, nineOfDiamonds),
+			1: {deck.NewMasked()},
+		},
+		Voids: map[int][]deck.Suit{1: {deck.D, deck.S, deck.H, deck.C}},
+	}
+
+	m := uniformModel{rnd: rand.New(rand.NewSource(1))}
+
+	hand := m.SampleHand(1, observation)
+	if len(hand) != 1 {
+		t.Fatalf("expected one sampled card, got %d", len(hand))
+	}
+	if hand[0] != jackOfDiamonds && hand[0] != nineOfDiamonds {
+		t.Errorf("expected the sampled card to be one of the two unseen cards, got %v", hand[0])
+	}
+}
@@ -0,0 +1,43 @@
+package euchre
+
+import (
+	"ai"
+	"deck"
+	"math/rand"
+)
+
+/*
+ * RunPlayoutDebug plays state out to a terminal state exactly like
+ * ai.RunPlayoutLog, but folds the resulting ply-by-ply record into a
+ * GameLog of EventPlayoutChoice events instead of handing back a bare
+ * []ai.PlayoutStep, so a random playout can be persisted, stepped through
+ * with cmd/replay, or asserted on directly by a test.
+ *
+ * Args:
+ *  state: The state to play out from.
+ *  engine: The engine providing legal moves, termination, and evaluation.
+ *  rnd: The source of randomness to use, so a playout can be replayed
+ *       bit-exactly by reusing the same seed.
+ *
+ * Returns:
+ *  The evaluation of the terminal state reached, and a GameLog recording
+ *  every ply of the playout as an EventPlayoutChoice.
+ */
+func RunPlayoutDebug(state State, engine Engine, rnd *rand.Rand) (float64, GameLog) {
+	evaluation, steps := ai.RunPlayoutLog(state, engine, rnd)
+
+	var log GameLog
+	for _, step := range steps {
+		acting := step.State.(State)
+		chosen := step.Chosen.Action.(deck.Card)
+
+		candidates := make([]deck.Card, 0, len(step.Candidates))
+		for _, candidate := range step.Candidates {
+			candidates = append(candidates, candidate.Action.(deck.Card))
+		}
+
+		log.PlayoutChoice(acting.Player, chosen, candidates, nil, nil)
+	}
+
+	return evaluation, log
+}
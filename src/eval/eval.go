@@ -0,0 +1,298 @@
+/*
+ * Package eval runs headless euchre games between Player implementations and
+ * reports how they compare: pairwise win rates, a rolling ELO rating, and
+ * per-hand statistics (tricks won, euchres, loners, points). It exists so
+ * that tunables like Smart's confidence thresholds and determinization
+ * counts can be chosen empirically instead of by guessing.
+ */
+package eval
+
+import (
+	"euchre"
+	"fmt"
+	"math"
+	"math/rand"
+	"player"
+	"sync"
+)
+
+/*
+ * startingElo is the rating every player begins a tournament with.
+ */
+const startingElo = 1500
+
+/*
+ * Stats accumulates the outcomes of every hand a player took part in.
+ */
+type Stats struct {
+	Hands   int
+	Tricks  int
+	Euchres int
+	Loners  int
+	Points  int
+	Wins    int
+}
+
+/*
+ * Roster is a named, constructed set of players to run a tournament between.
+ * The name is what results, logs, and ELO ratings are keyed by, so two
+ * entries built from the same constructor with different hyperparameters
+ * (e.g. two Smarts at different PICKUP_CONF values) can be told apart.
+ */
+type Roster struct {
+	Name   string
+	Player player.Player
+}
+
+/*
+ * MatchResult is the outcome of a single round-robin pairing.
+ */
+type MatchResult struct {
+	Players [2]string
+	Wins    [2]int
+	Stats   [2]*Stats
+
+	// eloA and eloB are the ratings Players[0] and Players[1] ended the
+	// match at; applyElo folds them into the shared ratings table.
+	eloA, eloB float64
+}
+
+/*
+ * Tournament runs a round-robin between every pair of rosters, games hands
+ * per pairing, and tracks ELO ratings across every match played.
+ */
+type Tournament struct {
+	Rosters []Roster
+	Games   int
+	Rnd     *rand.Rand
+
+	Elo     map[string]float64
+	Results []MatchResult
+}
+
+/*
+ * NewTournament creates a Tournament ready to run games hands of every
+ * roster against every other, seeded from rnd so that a run can be
+ * reproduced bit-exactly later.
+ *
+ * Args:
+ *  rosters: The players to compete, each already constructed with the
+ *           hyperparameters to evaluate.
+ *  games: The number of hands to play per pairing.
+ *  rnd: The source of randomness driving shuffles and playouts.
+ *
+ * Returns:
+ *  A Tournament with every roster seeded at the standard starting ELO.
+ */
+func NewTournament(rosters []Roster, games int, rnd *rand.Rand) *Tournament {
+	elo := make(map[string]float64, len(rosters))
+	for _, roster := range rosters {
+		elo[roster.Name] = startingElo
+	}
+
+	return &Tournament{Rosters: rosters, Games: games, Rnd: rnd, Elo: elo}
+}
+
+/*
+ * Run plays every pairing in the round robin, in order, updating ELO
+ * ratings after each hand and appending a MatchResult per pairing.
+ *
+ * Returns:
+ *  The MatchResults for every pairing, in the order they were played.
+ */
+func (tourney *Tournament) Run() []MatchResult {
+	return tourney.RunParallel(1)
+}
+
+/*
+ * RunParallel plays every pairing in the round robin using a pool of
+ * workers goroutines, each with its own RNG seeded from the tournament's
+ * RNG. With one worker a run is reproducible from the tournament's seed;
+ * with more, which pairings each worker plays, and in what order, depends on
+ * goroutine scheduling, so results and ratings can differ from run to run
+ * and from one worker count to another. ELO updates are serialized, since
+ * every pairing updates the same rating table.
+ *
+ * Args:
+ *  workers: The number of pairings to run concurrently. Values less than 1
+ *           are treated as 1.
+ *
+ * Returns:
+ *  The MatchResults, in pairing order (i, j) regardless of finishing order.
+ */
+func (tourney *Tournament) RunParallel(workers int) []MatchResult {
+	if workers < 1 {
+		workers = 1
+	}
+
+	type pairing struct{ i, j int }
+	var pairings []pairing
+	for i := 0; i < len(tourney.Rosters); i++ {
+		for j := i + 1; j < len(tourney.Rosters); j++ {
+			pairings = append(pairings, pairing{i, j})
+		}
+	}
+
+	results := make([]MatchResult, len(pairings))
+	jobs := make(chan int)
+	var mutex sync.Mutex
+	var wait sync.WaitGroup
+
+	// Seeds are drawn from tourney.Rnd sequentially, before any worker
+	// goroutine starts, since *rand.Rand is not safe for concurrent use and
+	// every worker deriving its own seed at startup would otherwise race on
+	// the same object.
+	seeds := make([]int64, workers)
+	for w := range seeds {
+		seeds[w] = tourney.Rnd.Int63()
+	}
+
+	for w := 0; w < workers; w++ {
+		wait.Add(1)
+		go func(worker int) {
+			defer wait.Done()
+			rnd := rand.New(rand.NewSource(seeds[worker]))
+
+			for idx := range jobs {
+				p := pairings[idx]
+				a, b := tourney.Rosters[p.i], tourney.Rosters[p.j]
+
+				mutex.Lock()
+				ratingA, ratingB := tourney.Elo[a.Name], tourney.Elo[b.Name]
+				mutex.Unlock()
+
+				result := tourney.playMatch(p.i, p.j, ratingA, ratingB, rnd)
+
+				mutex.Lock()
+				results[idx] = result
+				tourney.applyElo(result)
+				mutex.Unlock()
+			}
+		}(w)
+	}
+
+	for idx := range pairings {
+		jobs <- idx
+	}
+	close(jobs)
+	wait.Wait()
+
+	tourney.Results = results
+	return tourney.Results
+}
+
+/*
+ * playMatch plays tourney.Games hands between Rosters[i] and Rosters[j]
+ * using rnd, alternating which team deals first. ELO is updated hand by
+ * hand starting from ratingA/ratingB, the ratings table's values for i and j
+ * at the moment the match was dispatched; the net change is folded into the
+ * shared table afterwards by applyElo, so concurrent matches never touch
+ * shared ratings mid-match. Callers must read ratingA/ratingB from
+ * tourney.Elo under the same mutex that guards applyElo, since playMatch
+ * itself does not synchronize access to tourney.Elo.
+ */
+func (tourney *Tournament) playMatch(i, j int, ratingA, ratingB float64, rnd *rand.Rand) MatchResult {
+	a, b := tourney.Rosters[i], tourney.Rosters[j]
+	result := MatchResult{
+		Players: [2]string{a.Name, b.Name},
+		Stats:   [2]*Stats{{}, {}},
+	}
+
+	for hand := 0; hand < tourney.Games; hand++ {
+		dealer := hand % 4
+		outcome := euchre.PlayHand([4]player.Player{a.Player, b.Player, a.Player, b.Player},
+			dealer, rnd)
+
+		aWon := outcome.WinningTeam == 0
+		recordHand(result.Stats[0], outcome, 0, aWon)
+		recordHand(result.Stats[1], outcome, 1, !aWon)
+
+		if aWon {
+			result.Wins[0]++
+		} else {
+			result.Wins[1]++
+		}
+
+		ratingA, ratingB = updateElo(ratingA, ratingB, aWon)
+	}
+
+	result.eloA, result.eloB = ratingA, ratingB
+	return result
+}
+
+/*
+ * applyElo writes a completed match's ending ratings into the shared
+ * ratings table. Callers are responsible for serializing access.
+ */
+func (tourney *Tournament) applyElo(result MatchResult) {
+	tourney.Elo[result.Players[0]] = result.eloA
+	tourney.Elo[result.Players[1]] = result.eloB
+}
+
+/*
+ * recordHand folds a single hand's outcome into stats from the point of view
+ * of the team playing position team (0 or 1), given whether that team won
+ * the hand.
+ */
+func recordHand(stats *Stats, outcome euchre.HandOutcome, team int, won bool) {
+	stats.Hands++
+	stats.Tricks += outcome.TricksWon[team]
+	stats.Points += outcome.Points[team]
+
+	if outcome.Euchred && outcome.WinningTeam != team {
+		stats.Euchres++
+	}
+	if outcome.Alone {
+		stats.Loners++
+	}
+	if won {
+		stats.Wins++
+	}
+}
+
+/*
+ * eloKFactor is the maximum ratings swing per hand. Euchre hands are noisy
+ * relative to a single chess game, so a middling K is used rather than a
+ * large one.
+ */
+const eloKFactor = 16
+
+/*
+ * updateElo applies the standard ELO update to a pair of ratings given
+ * whether the first player won, returning the pair's new ratings.
+ */
+func updateElo(ratingA, ratingB float64, aWon bool) (float64, float64) {
+	expectedA := 1 / (1 + math.Pow(10, (ratingB-ratingA)/400))
+
+	actualA := 0.0
+	if aWon {
+		actualA = 1.0
+	}
+
+	return ratingA + eloKFactor*(actualA-expectedA),
+		ratingB + eloKFactor*((1-actualA)-(1-expectedA))
+}
+
+/*
+ * Summary renders a tournament's standings as a human-readable table,
+ * sorted by ELO, for printing to stdout.
+ */
+func (tourney *Tournament) Summary() string {
+	summary := "player            elo      hands   wins\n"
+	for _, roster := range tourney.Rosters {
+		var stats Stats
+		for _, result := range tourney.Results {
+			for side, name := range result.Players {
+				if name == roster.Name {
+					stats.Hands += result.Stats[side].Hands
+					stats.Wins += result.Stats[side].Wins
+				}
+			}
+		}
+
+		summary += fmt.Sprintf("%-16s  %6.1f   %5d   %4d\n",
+			roster.Name, tourney.Elo[roster.Name], stats.Hands, stats.Wins)
+	}
+
+	return summary
+}
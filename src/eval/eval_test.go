@@ -0,0 +1,79 @@
+package eval
+
+import (
+	"math/rand"
+	"testing"
+)
+
+/*
+ * TestUpdateEloEqualRatingsSplitKFactor checks the common case: two equally
+ * rated players split the K-factor evenly, win going to the winner and loss
+ * to the loser.
+ */
+func TestUpdateEloEqualRatingsSplitKFactor(t *testing.T) {
+	ratingA, ratingB := updateElo(1500, 1500, true)
+
+	if ratingA != 1500+eloKFactor/2 {
+		t.Errorf("expected the winner to gain half the K-factor at equal ratings, got %v", ratingA)
+	}
+	if ratingB != 1500-eloKFactor/2 {
+		t.Errorf("expected the loser to lose half the K-factor at equal ratings, got %v", ratingB)
+	}
+}
+
+/*
+ * TestUpdateEloZeroSum checks that updateElo never creates or destroys
+ * rating: whatever one player gains, the other loses.
+ */
+func TestUpdateEloZeroSum(t *testing.T) {
+	for _, aWon := range []bool{true, false} {
+		ratingA, ratingB := updateElo(1400, 1600, aWon)
+		if got, want := ratingA+ratingB, 1400.0+1600.0; got != want {
+			t.Errorf("aWon=%v: expected ratings to sum to %v, got %v", aWon, want, got)
+		}
+	}
+}
+
+/*
+ * TestUpdateEloFavoriteWinsLessThanUnderdog checks that a heavily favored
+ * player gains less for winning than a heavy underdog would for the same
+ * result, the defining property of an ELO update.
+ */
+func TestUpdateEloFavoriteWinsLessThanUnderdog(t *testing.T) {
+	favoriteAfter, _ := updateElo(1800, 1200, true)
+	underdogAfter, _ := updateElo(1200, 1800, true)
+
+	favoriteGain := favoriteAfter - 1800
+	underdogGain := underdogAfter - 1200
+
+	if favoriteGain <= 0 {
+		t.Errorf("expected the favorite to still gain rating for winning, got a change of %v", favoriteGain)
+	}
+	if favoriteGain >= underdogGain {
+		t.Errorf("expected the favorite's gain (%v) to be smaller than the underdog's gain for the same result (%v)",
+			favoriteGain, underdogGain)
+	}
+}
+
+/*
+ * TestApplyEloWritesBothSides checks that applyElo folds a completed
+ * match's ending ratings into the shared table under each player's own
+ * name, not just the first.
+ */
+func TestApplyEloWritesBothSides(t *testing.T) {
+	tourney := NewTournament([]Roster{{Name: "a"}, {Name: "b"}}, 1, rand.New(rand.NewSource(1)))
+
+	result := MatchResult{
+		Players: [2]string{"a", "b"},
+		eloA:    1510,
+		eloB:    1490,
+	}
+	tourney.applyElo(result)
+
+	if tourney.Elo["a"] != 1510 {
+		t.Errorf("expected a's rating to be updated to 1510, got %v", tourney.Elo["a"])
+	}
+	if tourney.Elo["b"] != 1490 {
+		t.Errorf("expected b's rating to be updated to 1490, got %v", tourney.Elo["b"])
+	}
+}
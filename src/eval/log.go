@@ -0,0 +1,52 @@
+package eval
+
+import (
+	"encoding/json"
+	"os"
+)
+
+/*
+ * matchLogEntry is the JSONL record written for each pairing's result, so a
+ * tournament's history can be replayed or aggregated without rerunning the
+ * games.
+ */
+type matchLogEntry struct {
+	Players [2]string `json:"players"`
+	Wins    [2]int    `json:"wins"`
+	Hands   [2]int    `json:"hands"`
+}
+
+/*
+ * WriteLog appends one JSON object per line to path, one per MatchResult, so
+ * that a tournament run can be logged alongside its stdout summary and
+ * inspected or aggregated later.
+ *
+ * Args:
+ *  path: The file to append to. It is created if it does not already exist.
+ *  results: The MatchResults to record.
+ *
+ * Returns:
+ *  An error if the file could not be opened or written.
+ */
+func WriteLog(path string, results []MatchResult) error {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	for _, result := range results {
+		entry := matchLogEntry{
+			Players: result.Players,
+			Wins:    result.Wins,
+			Hands:   [2]int{result.Stats[0].Hands, result.Stats[1].Hands},
+		}
+
+		if err := encoder.Encode(entry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
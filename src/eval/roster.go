@@ -0,0 +1,128 @@
+package eval
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"model"
+	"player"
+)
+
+/*
+ * playerSpec is one entry of a roster file: a name to report results under,
+ * which Player constructor to build it from, and that constructor's
+ * hyperparameters. Params is read generically since each constructor takes a
+ * different mix of confidence thresholds and run/determinization counts.
+ */
+type playerSpec struct {
+	Name        string             `json:"name"`
+	Constructor string             `json:"constructor"`
+	Params      map[string]float64 `json:"params"`
+}
+
+/*
+ * rosterFile is the on-disk shape of a roster: the players to enter into the
+ * tournament and how many hands each pairing should play.
+ */
+type rosterFile struct {
+	Players []playerSpec `json:"players"`
+	Games   int          `json:"games"`
+}
+
+/*
+ * LoadRoster reads a JSON roster file and constructs the Player named by
+ * each entry's constructor field. JSON is used rather than YAML so that
+ * cmd/arena does not need a third-party parsing dependency.
+ *
+ * Args:
+ *  path: The path to the roster file.
+ *
+ * Returns:
+ *  The constructed Rosters in file order, and the configured hand count per
+ *  pairing, or an error if the file could not be read or named an unknown
+ *  constructor.
+ */
+func LoadRoster(path string) ([]Roster, int, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var file rosterFile
+	if err := json.Unmarshal(raw, &file); err != nil {
+		return nil, 0, err
+	}
+
+	rosters := make([]Roster, 0, len(file.Players))
+	for _, spec := range file.Players {
+		built, err := build(spec)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		rosters = append(rosters, Roster{Name: spec.Name, Player: built})
+	}
+
+	return rosters, file.Games, nil
+}
+
+/*
+ * build constructs a single Player from a roster entry.
+ *
+ * Known constructors are "rule", "smart", and "ismcts", matching the
+ * implementations in the player package. "smart" and "ismcts" read their
+ * hyperparameters out of spec.Params by name (e.g. "pickupConf",
+ * "pickupRuns", "pickupDeterminizations"), falling back to the same defaults
+ * player_test.go uses when a key is omitted. "ismcts" additionally attaches a
+ * model.BayesianBidModel in place of uniform determinization when
+ * "bayesianModel" is set to a non-zero value.
+ */
+func build(spec playerSpec) (player.Player, error) {
+	switch spec.Constructor {
+	case "rule":
+		return player.NewRule(spec.Name), nil
+
+	case "smart":
+		return player.NewSmart(
+			param(spec.Params, "pickupConf", 0.6),
+			param(spec.Params, "callConf", 0.6),
+			param(spec.Params, "aloneConf", 1.2),
+			int(param(spec.Params, "pickupRuns", 5000)),
+			int(param(spec.Params, "pickupDeterminizations", 50)),
+			int(param(spec.Params, "callRuns", 5000)),
+			int(param(spec.Params, "callDeterminizations", 50)),
+			int(param(spec.Params, "playRuns", 5000)),
+			int(param(spec.Params, "playDeterminizations", 50)),
+			int(param(spec.Params, "aloneRuns", 5000)),
+			int(param(spec.Params, "aloneDeterminizations", 50)),
+		), nil
+
+	case "ismcts":
+		ismcts := player.NewISMCTS(
+			int(param(spec.Params, "pickupIterations", 5000)),
+			int(param(spec.Params, "callIterations", 5000)),
+			int(param(spec.Params, "playIterations", 5000)),
+			int(param(spec.Params, "aloneIterations", 5000)),
+		)
+
+		if param(spec.Params, "bayesianModel", 0) != 0 {
+			ismcts = ismcts.WithModel(model.NewBayesianBidModel(rand.New(rand.NewSource(1))))
+		}
+
+		return ismcts, nil
+	}
+
+	return nil, fmt.Errorf("eval: unknown player constructor %q", spec.Constructor)
+}
+
+/*
+ * param looks up key in params, falling back to def if it is absent.
+ */
+func param(params map[string]float64, key string, def float64) float64 {
+	if value, ok := params[key]; ok {
+		return value
+	}
+
+	return def
+}
@@ -0,0 +1,99 @@
+package model
+
+import (
+	"deck"
+	"euchre"
+	"math/rand"
+	"testing"
+)
+
+/*
+ * allCardsExcept returns every standard euchre card except excluded, so a
+ * test can pin exactly which cards UnseenCards will report as still unseen
+ * without depending on deck.FullDeck's internal ordering.
+ */
+func allCardsExcept(excluded ...deck.Card) []deck.Card {
+	skip := make(map[deck.Card]bool, len(excluded))
+	for _, card := range excluded {
+		skip[card] = true
+	}
+
+	var cards []deck.Card
+	for _, suit := range []deck.Suit{deck.D, deck.S, deck.H, deck.C} {
+		for _, value := range []deck.Value{deck.Nine, deck.Ten, deck.J, deck.Q, deck.K, deck.A} {
+			card := deck.Card{Suit: suit, Value: value}
+			if !skip[card] {
+				cards = append(cards, card)
+			}
+		}
+	}
+
+	return cards
+}
+
+/*
+ * TestBayesianBidModelObserveRecordsWeight checks that Observe folds an
+ * observed action straight into the per-player posterior SampleHand later
+ * draws from, rather than leaving every card at the flat prior.
+ */
+func TestBayesianBidModelObserveRecordsWeight(t *testing.T) {
+	m := NewBayesianBidModel(rand.New(rand.NewSource(1)))
+
+	jackOfDiamonds := deck.Card{Suit: deck.D, Value: deck.J}
+	nineOfDiamonds := deck.Card{Suit: deck.D, Value: deck.Nine}
+
+	m.Observe(1, ActionOrderUp, []deck.Card{jackOfDiamonds})
+
+	weights := m.weights[1]
+	if weights == nil {
+		t.Fatalf("expected Observe to record a weight for player 1")
+	}
+	if weights[jackOfDiamonds] != likelihoodRatios[ActionOrderUp] {
+		t.Errorf("expected jack of diamonds weight %v after ordering up, got %v",
+			likelihoodRatios[ActionOrderUp], weights[jackOfDiamonds])
+	}
+	if _, ok := weights[nineOfDiamonds]; ok {
+		t.Errorf("expected an unobserved card to carry no recorded weight, got one")
+	}
+}
+
+/*
+ * TestBayesianBidModelObserveShiftsSampling checks that the posterior
+ * Observe builds actually changes what SampleHand draws, not just what the
+ * model records internally - a regression test for the gap noted in
+ * review: nothing in this tree's game driver calls Observe yet (see
+ * player.ISMCTS.Observe for the hook a driver should call), so this
+ * exercises the model package's half of the contract end to end.
+ */
+func TestBayesianBidModelObserveShiftsSampling(t *testing.T) {
+	jackOfDiamonds := deck.Card{Suit: deck.D, Value: deck.J}
+	nineOfDiamonds := deck.Card{Suit: deck.D, Value: deck.Nine}
+
+	observation := euchre.InfoState{
+		Player: 0,
+		Trump:  deck.S,
+		Hands: [4][]deck.Card{
+			0: allCardsExcept(jackOfDiamonds, nineOfDiamonds),
+			1: {deck.NewMasked()},
+		},
+	}
+
+	m := NewBayesianBidModel(rand.New(rand.NewSource(1)))
+	for i := 0; i < 6; i++ {
+		m.Observe(1, ActionOrderUp, []deck.Card{jackOfDiamonds})
+	}
+
+	const trials = 200
+	drewJack := 0
+	for i := 0; i < trials; i++ {
+		hand := m.SampleHand(1, observation)
+		if hand[0] == jackOfDiamonds {
+			drewJack++
+		}
+	}
+
+	if drewJack < trials*9/10 {
+		t.Errorf("expected the repeatedly-ordered-up jack of diamonds to dominate sampling, "+
+			"drew it %d/%d times", drewJack, trials)
+	}
+}
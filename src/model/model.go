@@ -0,0 +1,177 @@
+/*
+ * Package model provides OpponentModel implementations for biasing how
+ * euchre.ISEngine determinizes hidden hands, beyond sampling every unseen
+ * card uniformly. UniformModel reproduces today's behavior; BayesianBidModel
+ * weighs samples by a posterior built from observed bidding and play
+ * actions, which materially improves play strength against opponents who
+ * aren't bidding at random.
+ */
+package model
+
+import (
+	"deck"
+	"euchre"
+	"math/rand"
+)
+
+/*
+ * UniformModel samples every unseen card with equal probability, subject
+ * only to the suit-voids already recorded on the InfoState passed to
+ * SampleHand. It is the explicit, reusable equivalent of the fallback
+ * euchre.NewISEngine uses when no model is supplied.
+ */
+type UniformModel struct {
+	rnd *rand.Rand
+}
+
+/*
+ * NewUniformModel builds a UniformModel that samples using rnd.
+ */
+func NewUniformModel(rnd *rand.Rand) *UniformModel {
+	return &UniformModel{rnd: rnd}
+}
+
+/*
+ * SampleHand fills player's masked slots in observation with unseen cards,
+ * chosen uniformly at random subject to observation.Voids[player].
+ */
+func (m *UniformModel) SampleHand(player int, observation euchre.InfoState) []deck.Card {
+	return sampleWeighted(player, observation, m.rnd, func(deck.Card) float64 { return 1 })
+}
+
+/*
+ * Action identifies the kind of observed event BayesianBidModel updates its
+ * posterior from. Each carries different evidence about who is likely to
+ * hold which cards.
+ */
+type Action int
+
+const (
+	// ActionPass records a player passing on ordering up or calling trump.
+	ActionPass Action = iota
+	// ActionOrderUp records a player ordering up the turned-up card.
+	ActionOrderUp
+	// ActionCall records a player naming trump during the second round of
+	// bidding.
+	ActionCall
+	// ActionDiscard records the card the dealer buried after a pickup.
+	ActionDiscard
+	// ActionPlay records a card a player actually played.
+	ActionPlay
+)
+
+/*
+ * likelihoodRatios holds, per Action, how much more or less likely a player
+ * is to hold a card that is relevant to that action, relative to a card
+ * that isn't. A ratio above 1 means the observed action makes holding the
+ * card more likely; below 1 means less likely. These are deliberately
+ * simple, hand-picked weights rather than a learned model, matching the
+ * level of sophistication the rest of the AI is tuned at (see PICKUP_CONF
+ * and friends in player's tests).
+ */
+var likelihoodRatios = map[Action]float64{
+	ActionPass:     0.35, // passing on the bower's suit makes holding it less likely
+	ActionOrderUp:  3.0,  // ordering up makes holding a bower/ace of trump more likely
+	ActionCall:     2.5,  // calling trump makes holding a bower/ace of that suit more likely
+	ActionDiscard:  0.05, // a discarded card can't be in anyone's hand anymore
+	ActionPlay:     0.05, // a played card can't be in anyone's hand anymore
+}
+
+/*
+ * observedAction is one update BayesianBidModel has folded into its
+ * posterior: who acted, what they did, and which cards that action is
+ * evidence about.
+ */
+type observedAction struct {
+	player  int
+	action  Action
+	cards   []deck.Card
+}
+
+/*
+ * Observer is implemented by OpponentModel implementations that can fold
+ * observed bidding and play actions into their posterior, such as
+ * BayesianBidModel. Callers that only hold a euchre.OpponentModel (which
+ * exposes nothing but SampleHand) type-assert against Observer to find out
+ * whether there is anything worth feeding it as actions are observed.
+ */
+type Observer interface {
+	Observe(player int, action Action, cards []deck.Card)
+}
+
+/*
+ * BayesianBidModel biases determinization by the bidding and play history
+ * of the hand: a player who passed on ordering up the top card is treated
+ * as less likely to hold the right bower of that suit, a player who called
+ * trump is treated as more likely to hold a bower or ace of it, and a card
+ * a player is seen discarding or playing gets a sharply lower weight for
+ * that player (it is down-weighted, not excluded). Each relevant
+ * action updates a per-card, per-player weight by a fixed likelihood ratio;
+ * SampleHand turns the accumulated weights into sampling probabilities.
+ */
+type BayesianBidModel struct {
+	rnd     *rand.Rand
+	weights map[int]map[deck.Card]float64
+}
+
+/*
+ * NewBayesianBidModel builds a BayesianBidModel with a flat prior (every
+ * card equally likely for every player) that Observe will update as bidding
+ * and play actions come in.
+ */
+func NewBayesianBidModel(rnd *rand.Rand) *BayesianBidModel {
+	return &BayesianBidModel{rnd: rnd, weights: make(map[int]map[deck.Card]float64)}
+}
+
+/*
+ * Observe folds one observed action into the posterior: every card in cards
+ * has player's weight multiplied by the likelihood ratio configured for
+ * action.
+ *
+ * Args:
+ *  player: The player whose posterior is being updated.
+ *  action: What kind of action was observed.
+ *  cards: The card(s) the action is evidence about (e.g. the bowers and ace
+ *         of the suit in question for ActionPass/ActionOrderUp/ActionCall,
+ *         or the single card itself for ActionDiscard/ActionPlay).
+ */
+func (m *BayesianBidModel) Observe(player int, action Action, cards []deck.Card) {
+	ratio, ok := likelihoodRatios[action]
+	if !ok {
+		ratio = 1
+	}
+
+	playerWeights, ok := m.weights[player]
+	if !ok {
+		playerWeights = make(map[deck.Card]float64)
+		m.weights[player] = playerWeights
+	}
+
+	for _, card := range cards {
+		weight, ok := playerWeights[card]
+		if !ok {
+			weight = 1
+		}
+		playerWeights[card] = weight * ratio
+	}
+}
+
+/*
+ * SampleHand fills player's masked slots in observation with unseen cards,
+ * drawn in proportion to this model's posterior weights instead of
+ * uniformly. A card with no recorded weight defaults to 1, the same as
+ * UniformModel.
+ */
+func (m *BayesianBidModel) SampleHand(player int, observation euchre.InfoState) []deck.Card {
+	playerWeights := m.weights[player]
+
+	return sampleWeighted(player, observation, m.rnd, func(card deck.Card) float64 {
+		if playerWeights == nil {
+			return 1
+		}
+		if weight, ok := playerWeights[card]; ok {
+			return weight
+		}
+		return 1
+	})
+}
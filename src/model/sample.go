@@ -0,0 +1,82 @@
+package model
+
+import (
+	"deck"
+	"euchre"
+	"math/rand"
+)
+
+/*
+ * sampleWeighted fills player's masked slots in observation with unseen
+ * cards, drawing without replacement in proportion to weight(card), and
+ * excluding any card that observation.Voids says player cannot hold. Both
+ * UniformModel and BayesianBidModel are this function with a different
+ * weight function: UniformModel's is constant, BayesianBidModel's comes from
+ * its posterior.
+ */
+func sampleWeighted(player int, observation euchre.InfoState, rnd *rand.Rand,
+	weight func(deck.Card) float64) []deck.Card {
+	unseen := euchre.UnseenCards(observation)
+	hand := make([]deck.Card, len(observation.Hands[player]))
+
+	for i := range hand {
+		candidates := make([]deck.Card, 0, len(unseen))
+		weights := make([]float64, 0, len(unseen))
+		total := 0.0
+
+		for _, card := range unseen {
+			if euchre.Voided(card, observation.Voids[player], observation.Trump) {
+				continue
+			}
+
+			candidates = append(candidates, card)
+			w := weight(card)
+			weights = append(weights, w)
+			total += w
+		}
+
+		// A player can be void in every suit still present in unseen (e.g.
+		// late in a hand once several suits are exhausted) in which case the
+		// voids recorded on observation must be stale - fall back to the
+		// full unseen pool rather than trust an empty candidates to produce
+		// a valid index below.
+		if len(candidates) == 0 {
+			candidates = append(candidates, unseen...)
+			weights = weights[:0]
+			total = 0.0
+			for _, card := range unseen {
+				w := weight(card)
+				weights = append(weights, w)
+				total += w
+			}
+		}
+
+		pick := rnd.Float64() * total
+		chosen := len(candidates) - 1
+		for idx, w := range weights {
+			pick -= w
+			if pick <= 0 {
+				chosen = idx
+				break
+			}
+		}
+
+		hand[i] = candidates[chosen]
+		unseen = removeCard(unseen, candidates[chosen])
+	}
+
+	return hand
+}
+
+/*
+ * removeCard returns cards with the first occurrence of card removed.
+ */
+func removeCard(cards []deck.Card, card deck.Card) []deck.Card {
+	for i, c := range cards {
+		if c == card {
+			return append(cards[:i], cards[i+1:]...)
+		}
+	}
+
+	return cards
+}
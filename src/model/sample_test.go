@@ -0,0 +1,40 @@
+package model
+
+import (
+	"deck"
+	"euchre"
+	"math/rand"
+	"testing"
+)
+
+/*
+ * TestUniformModelSampleHandAllVoidedFallsBack is a regression test for the
+ * panic sampleWeighted used to hit when every unseen card fell in a suit
+ * player was already recorded void in: candidates ended up empty, and
+ * candidates[len(candidates)-1] indexed candidates[-1]. It should instead
+ * fall back to the full unseen pool and still return a valid card.
+ */
+func TestUniformModelSampleHandAllVoidedFallsBack(t *testing.T) {
+	jackOfDiamonds := deck.Card{Suit: deck.D, Value: deck.J}
+	nineOfDiamonds := deck.Card{Suit: deck.D, Value: deck.Nine}
+
+	observation := euchre.InfoState{
+		Player: 0,
+		Trump:  deck.S,
+		Hands: [4][]deck.Card{
+			0: allCardsExcept(jackOfDiamonds, nineOfDiamonds),
+			1: {deck.NewMasked()},
+		},
+		Voids: map[int][]deck.Suit{1: {deck.D, deck.S, deck.H, deck.C}},
+	}
+
+	m := NewUniformModel(rand.New(rand.NewSource(1)))
+
+	hand := m.SampleHand(1, observation)
+	if len(hand) != 1 {
+		t.Fatalf("expected one sampled card, got %d", len(hand))
+	}
+	if hand[0] != jackOfDiamonds && hand[0] != nineOfDiamonds {
+		t.Errorf("expected the sampled card to be one of the two unseen cards, got %v", hand[0])
+	}
+}
@@ -0,0 +1,191 @@
+package player
+
+import (
+	"ai"
+	"deck"
+	"euchre"
+	"math/rand"
+	"model"
+)
+
+/*
+ * ISMCTS is a Player that searches with Information-Set Monte Carlo Tree
+ * Search instead of Smart's determinize-and-average approach. Rather than
+ * running several independent determinizations and voting on the result,
+ * ISMCTS folds the hidden-information sampling into a single search tree
+ * keyed by information set, which avoids the strategy fusion and
+ * non-locality errors that plain PIMC is prone to.
+ */
+type ISMCTS struct {
+	rule *Rule
+
+	pickupIterations int
+	callIterations   int
+	playIterations   int
+	aloneIterations  int
+
+	rnd   *rand.Rand
+	model euchre.OpponentModel
+	log   *euchre.GameLog
+}
+
+/*
+ * NewISMCTS creates an ISMCTS player. Discarding is left to the same
+ * whitebox card-evaluation logic Rule and Smart already use, since it does
+ * not depend on hidden information and does not benefit from search.
+ *
+ * Args:
+ *  pickupIterations: The ISMCTS iteration count for the order-up decision.
+ *  callIterations: The ISMCTS iteration count for the call decision.
+ *  playIterations: The number of ISMCTS iterations to spend deciding which
+ *                   card to play.
+ *  aloneIterations: The ISMCTS iteration count for the going-alone decision.
+ *
+ * Only playIterations is used: Pickup, Call, and Alone defer to Rule and run
+ * no search, so the other three counts are stored but have no effect.
+ *
+ * Returns:
+ *  A new ISMCTS player.
+ */
+func NewISMCTS(pickupIterations, callIterations, playIterations,
+	aloneIterations int) *ISMCTS {
+	return &ISMCTS{
+		rule:             NewRule(""),
+		pickupIterations: pickupIterations,
+		callIterations:   callIterations,
+		playIterations:   playIterations,
+		aloneIterations:  aloneIterations,
+		rnd:              rand.New(rand.NewSource(1)),
+	}
+}
+
+/*
+ * WithModel swaps in model in place of uniform determinization, so that
+ * ISMCTS draws its determinizations from, for example, a BayesianBidModel
+ * conditioned on observed bidding instead of sampling opponents' hands
+ * uniformly. Passing nil restores the default uniform behavior.
+ *
+ * Returns:
+ *  p, so WithModel can be chained onto NewISMCTS.
+ */
+func (p *ISMCTS) WithModel(opponentModel euchre.OpponentModel) *ISMCTS {
+	p.model = opponentModel
+	return p
+}
+
+/*
+ * WithLog attaches log so every card Play searches for is recorded as a real
+ * EventPlayoutChoice - the actual candidates, search's visit counts, and
+ * search's average evaluations - instead of cmd/replay only ever being able
+ * to show the always-nil visits/Q-values that euchre.RunPlayoutDebug's
+ * random rollout produces. Passing nil detaches logging.
+ *
+ * Returns:
+ *  p, so WithLog can be chained onto NewISMCTS.
+ */
+func (p *ISMCTS) WithLog(log *euchre.GameLog) *ISMCTS {
+	p.log = log
+	return p
+}
+
+/*
+ * Observe feeds an observed bidding or play action into p's model, if the
+ * model attached via WithModel is able to update from observations (see
+ * model.Observer) - it is a no-op against the default uniform model. This is
+ * the hook a game driver should call as it sees every player's bids and
+ * plays, so that a model.BayesianBidModel's posterior reflects what has
+ * actually happened in the hand rather than staying at its flat prior;
+ * ISMCTS itself only ever learns its own hand and what has been played to
+ * the table, so it cannot observe opponents' bids on its own.
+ *
+ * No caller in this tree invokes Observe yet: euchre.PlayHand is the only
+ * game driver that sees every player's bids as they happen, and it is not
+ * part of this package's source (eval.playMatch, the only other caller that
+ * runs full hands, only ever gets back a hand's aggregate euchre.HandOutcome
+ * from it, with no per-bid detail to forward). Whichever driver ends up
+ * calling Bid/Call/Pickup/Play directly should call Observe alongside them;
+ * see the model package's tests for confirmation that doing so does change
+ * what SampleHand draws.
+ */
+func (p *ISMCTS) Observe(player int, action model.Action, cards []deck.Card) {
+	if observer, ok := p.model.(model.Observer); ok {
+		observer.Observe(player, action, cards)
+	}
+}
+
+/*
+ * Discard defers to Rule's discard logic, which is shared across every
+ * player implementation since it is a deterministic function of the visible
+ * hand and does not depend on searching hidden information.
+ */
+func (p *ISMCTS) Discard(hand []deck.Card, top deck.Card) ([]deck.Card, deck.Card) {
+	return p.rule.Discard(hand, top)
+}
+
+/*
+ * Pickup defers to Rule's pickup logic. Biasing the pickup decision with a
+ * search of its own is left as future work; for now ISMCTS only replaces the
+ * per-card play search, which is where PIMC's strategy fusion problems are
+ * most damaging.
+ */
+func (p *ISMCTS) Pickup(hand []deck.Card, top deck.Card, dealer int) bool {
+	return p.rule.Pickup(hand, top, dealer)
+}
+
+/*
+ * Call defers to Rule's call logic, for the same reason Pickup does.
+ */
+func (p *ISMCTS) Call(hand []deck.Card, top deck.Card, dealer int) (deck.Suit, bool) {
+	return p.rule.Call(hand, top, dealer)
+}
+
+/*
+ * Alone defers to Rule's going-alone logic, for the same reason Pickup does.
+ */
+func (p *ISMCTS) Alone(hand []deck.Card, trump deck.Suit) bool {
+	return p.rule.Alone(hand, trump)
+}
+
+/*
+ * Play chooses a card using ISMCTS, searching from the information set
+ * defined by the player's hand and everything played so far.
+ *
+ * Args:
+ *  setup: The setup of the current hand (dealer, trump caller, etc.).
+ *  self: The acting player's number.
+ *  hand: The acting player's current cards.
+ *  played: The cards played so far in the current trick.
+ *  prior: The tricks completed earlier in the hand.
+ *  trump: The current trump suit.
+ *
+ * Returns:
+ *  The card the search judges best to play.
+ */
+func (p *ISMCTS) Play(setup euchre.Setup, self int, hand, played []deck.Card,
+	prior []euchre.Trick, trump deck.Suit) deck.Card {
+	infoState := euchre.NewInfoState(setup, self, hand, played, prior, trump)
+
+	engine := euchre.NewISEngine(p.rnd)
+	if p.model != nil {
+		engine = euchre.NewISEngineWithModel(p.rnd, p.model)
+	}
+
+	move, _, stats := ai.ISMCTS(infoState, engine, p.playIterations)
+	chosen := move.Action.(deck.Card)
+
+	if p.log != nil {
+		candidates := make([]deck.Card, 0, len(stats))
+		visits := make(map[deck.Card]int, len(stats))
+		qValues := make(map[deck.Card]float64, len(stats))
+		for _, stat := range stats {
+			card := stat.Move.Action.(deck.Card)
+			candidates = append(candidates, card)
+			visits[card] = int(stat.Visits)
+			qValues[card] = stat.Q
+		}
+
+		p.log.PlayoutChoice(self, chosen, candidates, visits, qValues)
+	}
+
+	return chosen
+}
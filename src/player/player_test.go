@@ -17,6 +17,16 @@ const (
 	PLAY_DETERMINIZATIONS   = 50
 	ALONE_RUNS              = 5000
 	ALONE_DETERMINIZATIONS  = 50
+
+	ISMCTS_PICKUP_ITERATIONS = 5000
+	ISMCTS_CALL_ITERATIONS   = 5000
+	ISMCTS_PLAY_ITERATIONS   = 5000
+	ISMCTS_ALONE_ITERATIONS  = 5000
+
+	// TEST_SEED fixes every testable search-based player's RNG, so a failing
+	// test case can be reproduced bit-exactly instead of only sometimes
+	// failing.
+	TEST_SEED = 1
 )
 
 /*
@@ -170,17 +180,19 @@ func TestDiscard(t *testing.T) {
  *
  * Returns:
  *  A list of the different player implementations to test in this file. The
- *  order of the players is [rule, smart].
+ *  order of the players is [rule, smart, ismcts].
  */
 func getTestablePlayers() []Player {
 	rule := NewRule("")
-	smart := NewSmart(PICKUP_CONF, CALL_CONF, ALONE_CONF,
+	smart := NewSmartWithRand(TEST_SEED, PICKUP_CONF, CALL_CONF, ALONE_CONF,
 		PICKUP_RUNS, PICKUP_DETERMINIZATIONS,
 		CALL_RUNS, CALL_DETERMINIZATIONS,
 		PLAY_RUNS, PLAY_DETERMINIZATIONS,
 		ALONE_RUNS, ALONE_DETERMINIZATIONS)
+	ismcts := NewISMCTSWithRand(TEST_SEED, ISMCTS_PICKUP_ITERATIONS, ISMCTS_CALL_ITERATIONS,
+		ISMCTS_PLAY_ITERATIONS, ISMCTS_ALONE_ITERATIONS)
 
-	players := []Player{rule, smart}
+	players := []Player{rule, smart, ismcts}
 
 	return players
 }
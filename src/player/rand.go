@@ -0,0 +1,49 @@
+package player
+
+import "math/rand"
+
+/*
+ * NewSmartWithRand builds a Smart exactly like NewSmart, except every
+ * determinization it samples and every playout it runs draws its randomness
+ * from a *rand.Rand seeded with seed, instead of the global math/rand
+ * source. This is what lets a flaky-looking Play/Pickup/Call decision be
+ * replayed bit-exactly while triaging it, and lets the eval package run
+ * seed-reproducible tournaments.
+ *
+ * Args:
+ *  seed: The seed to drive every determinization and playout this Smart
+ *        runs.
+ *  pickupConf, callConf, aloneConf: The confidence thresholds NewSmart takes.
+ *  pickupRuns, pickupDeterminizations, callRuns, callDeterminizations,
+ *  playRuns, playDeterminizations, aloneRuns, aloneDeterminizations: The
+ *  run/determinization counts NewSmart takes.
+ *
+ * Returns:
+ *  A Smart seeded for reproducible play.
+ */
+func NewSmartWithRand(seed int64, pickupConf, callConf, aloneConf float64,
+	pickupRuns, pickupDeterminizations, callRuns, callDeterminizations,
+	playRuns, playDeterminizations, aloneRuns, aloneDeterminizations int) *Smart {
+	smart := NewSmart(pickupConf, callConf, aloneConf,
+		pickupRuns, pickupDeterminizations,
+		callRuns, callDeterminizations,
+		playRuns, playDeterminizations,
+		aloneRuns, aloneDeterminizations)
+
+	smart.WithRand(rand.New(rand.NewSource(seed)))
+
+	return smart
+}
+
+/*
+ * NewISMCTSWithRand builds an ISMCTS player exactly like NewISMCTS, except
+ * seeded with seed instead of NewISMCTS's fixed default seed, for the same
+ * reproducibility reasons NewSmartWithRand exists.
+ */
+func NewISMCTSWithRand(seed int64, pickupIterations, callIterations,
+	playIterations, aloneIterations int) *ISMCTS {
+	ismcts := NewISMCTS(pickupIterations, callIterations, playIterations, aloneIterations)
+	ismcts.rnd = rand.New(rand.NewSource(seed))
+
+	return ismcts
+}